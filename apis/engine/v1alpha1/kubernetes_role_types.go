@@ -0,0 +1,136 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	kmapi "kmodules.xyz/client-go/api/v1"
+
+	core "k8s.io/api/core/v1"
+	rbac "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ResourceKindKubernetesRole   = "KubernetesRole"
+	ResourceKubernetesRole       = "kubernetesrole"
+	ResourcePluralKubernetesRole = "kubernetesroles"
+)
+
+// KubernetesRoleType specifies the type of the Kubernetes role Vault should
+// generate a service account for: a namespaced Role or a cluster-wide
+// ClusterRole.
+type KubernetesRoleType string
+
+const (
+	KubernetesRoleTypeRole        KubernetesRoleType = "Role"
+	KubernetesRoleTypeClusterRole KubernetesRoleType = "ClusterRole"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// KubernetesRole is the Schema for the Kubernetes secrets engine role API.
+// It configures Vault's `kubernetes` secrets engine to generate short-lived
+// Kubernetes service account tokens on demand.
+type KubernetesRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KubernetesRoleSpec   `json:"spec,omitempty"`
+	Status KubernetesRoleStatus `json:"status,omitempty"`
+}
+
+// KubernetesRoleSpec defines the desired state of a Vault Kubernetes
+// secrets engine role. It mirrors the parameters accepted by Vault's
+// `kubernetes/roles/:name` endpoint.
+//
+// See: https://www.vaultproject.io/docs/secrets/kubernetes
+type KubernetesRoleSpec struct {
+	// VaultRef is the name of a local AppBinding referring to a Vault server.
+	VaultRef core.LocalObjectReference `json:"vaultRef"`
+
+	// Path is the mount path of the Kubernetes secrets engine. Default is
+	// `kubernetes`.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// AllowedKubernetesNamespaces is the list of Kubernetes namespaces the
+	// generated service account is allowed to be created in. `*` allows any
+	// namespace.
+	AllowedKubernetesNamespaces []string `json:"allowedKubernetesNamespaces,omitempty"`
+
+	// ServiceAccountName is the name of the pre-existing service account to
+	// generate a token for. Mutually exclusive with GeneratedRoleRules.
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
+	// KubernetesRoleName is the name of the pre-existing Role or ClusterRole
+	// to bind the generated service account to. Mutually exclusive with
+	// GeneratedRoleRules.
+	// +optional
+	KubernetesRoleName string `json:"kubernetesRoleName,omitempty"`
+
+	// KubernetesRoleType is the type of role bound to the generated service
+	// account: Role or ClusterRole. Defaults to Role.
+	// +optional
+	KubernetesRoleType KubernetesRoleType `json:"kubernetesRoleType,omitempty"`
+
+	// GeneratedRoleRules are the PolicyRules Vault should use to generate a
+	// Role/ClusterRole on the fly instead of binding to an existing one.
+	// Vault's `generated_role_rules` parameter takes this list marshaled to
+	// a JSON string, not a nested object (see kubernetes.CreateRole).
+	// +optional
+	GeneratedRoleRules []rbac.PolicyRule `json:"generatedRoleRules,omitempty"`
+
+	// TokenDefaultTTL is the default TTL for generated tokens, e.g. "1h".
+	// +optional
+	TokenDefaultTTL string `json:"tokenDefaultTTL,omitempty"`
+
+	// TokenMaxTTL is the max allowed TTL for generated tokens, e.g. "24h".
+	// +optional
+	TokenMaxTTL string `json:"tokenMaxTTL,omitempty"`
+}
+
+// KubernetesRolePhase is the observed phase of a KubernetesRole. The
+// phase's values (e.g. controller.KubernetesRolePhaseSuccess) are defined
+// alongside the controller that sets them, matching GCPRolePhase.
+type KubernetesRolePhase string
+
+// KubernetesRoleStatus defines the observed state of KubernetesRole.
+type KubernetesRoleStatus struct {
+	Phase              KubernetesRolePhase `json:"phase,omitempty"`
+	ObservedGeneration int64               `json:"observedGeneration,omitempty"`
+	Conditions         []kmapi.Condition   `json:"conditions,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// KubernetesRoleList contains a list of KubernetesRole.
+type KubernetesRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KubernetesRole `json:"items"`
+}
+
+// RoleName returns the name Vault should use for this role: the
+// Kubernetes object's namespace and name joined with a hyphen, so roles
+// with the same name in different namespaces get distinct Vault role
+// names. The join is not unambiguous when the namespace or name itself
+// contains a hyphen ("a-b"/"c" and "a"/"b-c" both give "a-b-c").
+func (k *KubernetesRole) RoleName() string {
+	return k.Namespace + "-" + k.Name
+}
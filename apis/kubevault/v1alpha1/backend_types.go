@@ -0,0 +1,246 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// BackendStorageSpec selects and configures the Vault storage backend for
+// a VaultServer. Exactly one field should be set; it is the populated
+// field's registered name (see pkg/vault/storage) that the VaultServer
+// reconciler looks the backend implementation up by.
+type BackendStorageSpec struct {
+	// +optional
+	Inmem *InmemSpec `json:"inmem,omitempty"`
+	// +optional
+	Etcd *EtcdSpec `json:"etcd,omitempty"`
+	// +optional
+	GCS *GcsSpec `json:"gcs,omitempty"`
+	// +optional
+	S3 *S3Spec `json:"s3,omitempty"`
+	// +optional
+	Azure *AzureSpec `json:"azure,omitempty"`
+	// +optional
+	Consul *ConsulSpec `json:"consul,omitempty"`
+	// +optional
+	DynamoDB *DynamoDBSpec `json:"dynamodb,omitempty"`
+	// +optional
+	MySQL *MySQLSpec `json:"mysql,omitempty"`
+	// +optional
+	PostgreSQL *PostgreSQLSpec `json:"postgresql,omitempty"`
+	// +optional
+	File *FileSpec `json:"file,omitempty"`
+	// +optional
+	Raft *RaftSpec `json:"raft,omitempty"`
+	// Custom configures a Vault storage backend that the operator has no
+	// built-in driver for, by emitting a raw HCL block.
+	// +optional
+	Custom *CustomStorageSpec `json:"custom,omitempty"`
+}
+
+// BackendName returns the registered pkg/vault/storage name of whichever
+// backend is populated on spec, or "" if none is set. For Custom it returns
+// "custom", a name that is not in that registry.
+func (s BackendStorageSpec) BackendName() string {
+	switch {
+	case s.Inmem != nil:
+		return "inmem"
+	case s.Etcd != nil:
+		return "etcd"
+	case s.GCS != nil:
+		return "gcs"
+	case s.S3 != nil:
+		return "s3"
+	case s.Azure != nil:
+		return "azure"
+	case s.Consul != nil:
+		return "consul"
+	case s.DynamoDB != nil:
+		return "dynamodb"
+	case s.MySQL != nil:
+		return "mysql"
+	case s.PostgreSQL != nil:
+		return "postgresql"
+	case s.File != nil:
+		return "file"
+	case s.Raft != nil:
+		return "raft"
+	case s.Custom != nil:
+		return "custom"
+	}
+	return ""
+}
+
+// InmemSpec configures Vault's non-durable in-memory storage backend.
+// Intended for dev/test VaultServers only.
+type InmemSpec struct{}
+
+// GcsSpec configures Google Cloud Storage as Vault's storage backend.
+//
+// See: https://www.vaultproject.io/docs/configuration/storage/google-cloud-storage
+type GcsSpec struct {
+	// Bucket is the name of the GCS bucket to use.
+	Bucket string `json:"bucket"`
+	// CredentialSecretName refers to a Secret containing a GCP service
+	// account JSON key, mounted into the Vault pod and referenced via
+	// GOOGLE_APPLICATION_CREDENTIALS.
+	// +optional
+	CredentialSecretName string `json:"credentialSecretName,omitempty"`
+	// HAEnable indicates whether high availability mode is enabled.
+	// +optional
+	HAEnable bool `json:"haEnable,omitempty"`
+}
+
+// S3Spec configures AWS S3 as Vault's storage backend.
+//
+// See: https://www.vaultproject.io/docs/configuration/storage/s3
+type S3Spec struct {
+	// Bucket is the name of the S3 bucket to use.
+	Bucket string `json:"bucket"`
+	// Region is the AWS region the bucket lives in.
+	// +optional
+	Region string `json:"region,omitempty"`
+	// CredentialSecretName refers to a Secret with `access_key`/`secret_key`
+	// keys, injected as AWS_ACCESS_KEY_ID/AWS_SECRET_ACCESS_KEY.
+	// +optional
+	CredentialSecretName string `json:"credentialSecretName,omitempty"`
+}
+
+// AzureSpec configures Azure Storage as Vault's storage backend.
+//
+// See: https://www.vaultproject.io/docs/configuration/storage/azure
+type AzureSpec struct {
+	// Container is the name of the Azure Storage container to use.
+	Container string `json:"container"`
+	// CredentialSecretName refers to a Secret with `account_name`/
+	// `account_key` keys.
+	CredentialSecretName string `json:"credentialSecretName"`
+}
+
+// ConsulSpec configures HashiCorp Consul as Vault's storage backend.
+//
+// See: https://www.vaultproject.io/docs/configuration/storage/consul
+type ConsulSpec struct {
+	// Address is the address of the Consul agent, e.g. "127.0.0.1:8500".
+	Address string `json:"address"`
+	// Path is the path in Consul's key-value store under which Vault data
+	// will be stored. Defaults to "vault/".
+	// +optional
+	Path string `json:"path,omitempty"`
+	// Scheme is "http" or "https". Defaults to "http".
+	// +optional
+	Scheme string `json:"scheme,omitempty"`
+	// TokenSecretName refers to a Secret containing the Consul ACL token
+	// under the key `token`.
+	// +optional
+	TokenSecretName string `json:"tokenSecretName,omitempty"`
+}
+
+// DynamoDBSpec configures AWS DynamoDB as Vault's storage backend.
+//
+// See: https://www.vaultproject.io/docs/configuration/storage/dynamodb
+type DynamoDBSpec struct {
+	// Table is the name of the DynamoDB table to use.
+	Table string `json:"table"`
+	// Region is the AWS region the table lives in.
+	// +optional
+	Region string `json:"region,omitempty"`
+	// CredentialSecretName refers to a Secret with `access_key`/`secret_key`
+	// keys, injected as AWS_ACCESS_KEY_ID/AWS_SECRET_ACCESS_KEY.
+	// +optional
+	CredentialSecretName string `json:"credentialSecretName,omitempty"`
+	// HAEnable indicates whether high availability mode is enabled.
+	// +optional
+	HAEnable bool `json:"haEnable,omitempty"`
+}
+
+// MySQLSpec configures MySQL as Vault's storage backend.
+//
+// See: https://www.vaultproject.io/docs/configuration/storage/mysql
+type MySQLSpec struct {
+	// Address is the MySQL server address, e.g. "127.0.0.1:3306".
+	Address string `json:"address"`
+	// Database is the database name Vault data is stored in.
+	// +optional
+	Database string `json:"database,omitempty"`
+	// Table is the table name Vault data is stored in.
+	// +optional
+	Table string `json:"table,omitempty"`
+	// CredentialSecretName refers to a Secret with `username`/`password`
+	// keys.
+	CredentialSecretName string `json:"credentialSecretName"`
+}
+
+// PostgreSQLSpec configures PostgreSQL as Vault's storage backend.
+//
+// See: https://www.vaultproject.io/docs/configuration/storage/postgresql
+type PostgreSQLSpec struct {
+	// Table is the table name Vault data is stored in.
+	// +optional
+	Table string `json:"table,omitempty"`
+	// ConnectionURLSecretName refers to a Secret containing the Postgres
+	// connection URL under the key `connection_url`.
+	ConnectionURLSecretName string `json:"connectionURLSecretName"`
+}
+
+// FileSpec configures the local filesystem as Vault's storage backend.
+// Intended for single-replica, non-HA VaultServers.
+//
+// See: https://www.vaultproject.io/docs/configuration/storage/filesystem
+type FileSpec struct {
+	// Path is the filesystem path Vault data is stored under.
+	Path string `json:"path"`
+}
+
+// RaftSpec configures Vault's integrated Raft storage backend.
+//
+// See: https://www.vaultproject.io/docs/configuration/storage/raft
+type RaftSpec struct {
+	// Path is the filesystem path raft data is stored under.
+	Path string `json:"path"`
+	// NodeID uniquely identifies this node within the raft cluster.
+	// +optional
+	NodeID string `json:"nodeID,omitempty"`
+}
+
+// CustomStorageSpec configures a Vault storage backend the operator has no
+// built-in driver for, by emitting a raw HCL block and wiring up whatever
+// credentials/TLS material it needs.
+type CustomStorageSpec struct {
+	// Name is the Vault storage backend name, e.g. "cockroachdb" — must
+	// match a storage driver Vault's binary understands.
+	Name string `json:"name"`
+	// Parameters is the raw HCL placed verbatim inside the
+	// `storage "<name>" { ... }` block.
+	// +optional
+	Parameters string `json:"parameters,omitempty"`
+	// SecretVolumes mounts the referenced Secrets onto the Vault pod, for
+	// credential/TLS material the custom backend needs on disk.
+	// +optional
+	SecretVolumes []NamedSecretVolumeSource `json:"secretVolumes,omitempty"`
+	// EnvFromSecrets injects the referenced Secrets as environment
+	// variables for credential material the custom backend's Vault plugin
+	// reads from the environment.
+	// +optional
+	EnvFromSecrets []string `json:"envFromSecrets,omitempty"`
+}
+
+// NamedSecretVolumeSource mounts a Secret at a given path in the Vault
+// container.
+type NamedSecretVolumeSource struct {
+	// SecretName is the name of the Secret to mount.
+	SecretName string `json:"secretName"`
+	// MountPath is the path inside the Vault container to mount it at.
+	MountPath string `json:"mountPath"`
+}
@@ -0,0 +1,75 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// EtcdSpec defines the configuration to set up etcd as Vault's storage
+// backend.
+//
+// See: https://www.vaultproject.io/docs/configuration/storage/etcd.html
+type EtcdSpec struct {
+	// Address is the address(es) of the etcd cluster, comma separated.
+	// +optional
+	Address string `json:"address,omitempty"`
+
+	// EtcdApi is the etcd API version to use, either "v2" or "v3".
+	// +optional
+	EtcdApi string `json:"etcdApi,omitempty"`
+
+	// Path is the path in etcd's key space under which Vault data will be
+	// stored.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// DiscoverySrv, if set, specifies the DNS SRV record to use for etcd
+	// autodiscovery.
+	// +optional
+	DiscoverySrv string `json:"discoverySrv,omitempty"`
+
+	// HAEnable indicates whether high availability mode is enabled.
+	// +optional
+	HAEnable bool `json:"haEnable,omitempty"`
+
+	// Sync enables cluster member autodiscovery via the etcd client.
+	// +optional
+	Sync bool `json:"sync,omitempty"`
+
+	// RequestTimeout is the timeout for individual etcd requests, e.g.
+	// "5s". Defaults to "5s" when unset.
+	// +optional
+	RequestTimeout string `json:"requestTimeout,omitempty"`
+
+	// LockTimeout is the timeout for acquiring the etcd HA lock, e.g.
+	// "60s". Defaults to "60s" when unset.
+	// +optional
+	LockTimeout string `json:"lockTimeout,omitempty"`
+
+	// MaxReceiveMessageSize is the maximum size, in bytes, of gRPC messages
+	// the etcd client will accept. Defaults to etcd's client default
+	// (4194304) when unset.
+	// +optional
+	MaxReceiveMessageSize int `json:"maxReceiveMessageSize,omitempty"`
+
+	// TLSSecretName is the name of the secret containing TLS client
+	// certificates to authenticate to etcd with.
+	// +optional
+	TLSSecretName string `json:"tlsSecretName,omitempty"`
+
+	// CredentialSecretName is the name of the secret containing etcd
+	// username/password credentials.
+	// +optional
+	CredentialSecretName string `json:"credentialSecretName,omitempty"`
+}
@@ -0,0 +1,240 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	api "kubevault.dev/operator/apis/engine/v1alpha1"
+	patchutil "kubevault.dev/operator/client/clientset/versioned/typed/engine/v1alpha1/util"
+	"kubevault.dev/operator/pkg/vault/role/kubernetes"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	kmapi "kmodules.xyz/client-go/api/v1"
+	core_util "kmodules.xyz/client-go/core/v1"
+	"kmodules.xyz/client-go/tools/queue"
+)
+
+const (
+	KubernetesRolePhaseSuccess api.KubernetesRolePhase = "Success"
+	KubernetesRoleFinalizer    string                  = "kubernetesrole.engine.kubevault.com"
+)
+
+func (c *VaultController) initKubernetesRoleWatcher() {
+	c.kubernetesRoleInformer = c.extInformerFactory.Engine().V1alpha1().KubernetesRoles().Informer()
+	c.kubernetesRoleQueue = queue.New(api.ResourceKindKubernetesRole, c.MaxNumRequeues, c.NumThreads, c.runKubernetesRoleInjector)
+	c.kubernetesRoleInformer.AddEventHandler(queue.NewReconcilableHandler(c.kubernetesRoleQueue.GetQueue()))
+	c.kubernetesRoleLister = c.extInformerFactory.Engine().V1alpha1().KubernetesRoles().Lister()
+}
+
+func (c *VaultController) runKubernetesRoleInjector(key string) error {
+	obj, exist, err := c.kubernetesRoleInformer.GetIndexer().GetByKey(key)
+	if err != nil {
+		glog.Errorf("Fetching object with key %s from store failed with %v", key, err)
+		return err
+	}
+
+	if !exist {
+		glog.Warningf("KubernetesRole %s does not exist anymore", key)
+
+	} else {
+		role := obj.(*api.KubernetesRole).DeepCopy()
+
+		glog.Infof("Sync/Add/Update for KubernetesRole %s/%s", role.Namespace, role.Name)
+
+		if role.DeletionTimestamp != nil {
+			if core_util.HasFinalizer(role.ObjectMeta, KubernetesRoleFinalizer) {
+				go c.runKubernetesRoleFinalizer(role, finalizerTimeout, finalizerInterval)
+			}
+		} else {
+			if !core_util.HasFinalizer(role.ObjectMeta, KubernetesRoleFinalizer) {
+				// Add finalizer
+				_, _, err := patchutil.PatchKubernetesRole(context.TODO(), c.extClient.EngineV1alpha1(), role, func(role *api.KubernetesRole) *api.KubernetesRole {
+					role.ObjectMeta = core_util.AddFinalizer(role.ObjectMeta, KubernetesRoleFinalizer)
+					return role
+				}, metav1.PatchOptions{})
+				if err != nil {
+					return errors.Wrapf(err, "failed to set KubernetesRole finalizer for %s/%s", role.Namespace, role.Name)
+				}
+			}
+
+			kRClient, err := kubernetes.NewKubernetesRole(c.kubeClient, c.appCatalogClient, role)
+			if err != nil {
+				return err
+			}
+
+			err = c.reconcileKubernetesRole(kRClient, role)
+			if err != nil {
+				return errors.Wrapf(err, "for KubernetesRole %s/%s:", role.Namespace, role.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// Will do:
+//	For vault:
+// 	  - configure a Kubernetes role
+//    - sync role
+func (c *VaultController) reconcileKubernetesRole(kRClient kubernetes.KubernetesRoleInterface, role *api.KubernetesRole) error {
+	// create role
+	err := kRClient.CreateRole()
+	if err != nil {
+		_, err2 := patchutil.UpdateKubernetesRoleStatus(
+			context.TODO(),
+			c.extClient.EngineV1alpha1(),
+			role.ObjectMeta, func(status *api.KubernetesRoleStatus) *api.KubernetesRoleStatus {
+				status.Conditions = []kmapi.Condition{
+					{
+						Type:    kmapi.ConditionFailure,
+						Status:  kmapi.ConditionTrue,
+						Reason:  "FailedToCreateRole",
+						Message: err.Error(),
+					},
+				}
+				return status
+			},
+			metav1.UpdateOptions{},
+		)
+		return utilerrors.NewAggregate([]error{err2, errors.Wrap(err, "failed to create role")})
+	}
+
+	_, err = patchutil.UpdateKubernetesRoleStatus(
+		context.TODO(),
+		c.extClient.EngineV1alpha1(),
+		role.ObjectMeta, func(status *api.KubernetesRoleStatus) *api.KubernetesRoleStatus {
+			status.Conditions = []kmapi.Condition{}
+			status.Phase = KubernetesRolePhaseSuccess
+			status.ObservedGeneration = role.Generation
+			return status
+		},
+		metav1.UpdateOptions{},
+	)
+	return err
+}
+
+func (c *VaultController) runKubernetesRoleFinalizer(role *api.KubernetesRole, timeout time.Duration, interval time.Duration) {
+	if role == nil {
+		glog.Infoln("KubernetesRole is nil")
+		return
+	}
+
+	id := getKubernetesRoleId(role)
+	if c.finalizerInfo.IsAlreadyProcessing(id) {
+		// already processing
+		return
+	}
+
+	glog.Infof("Processing finalizer for KubernetesRole %s/%s", role.Namespace, role.Name)
+	// Add key to finalizerInfo, it will prevent other go routine to processing for this KubernetesRole
+	c.finalizerInfo.Add(id)
+
+	stopCh := time.After(timeout)
+	finalizationDone := false
+	timeOutOccured := false
+	attempt := 0
+
+	for {
+		glog.Infof("KubernetesRole %s/%s finalizer: attempt %d\n", role.Namespace, role.Name, attempt)
+
+		select {
+		case <-stopCh:
+			timeOutOccured = true
+		default:
+		}
+
+		if timeOutOccured {
+			break
+		}
+
+		if !finalizationDone {
+			d, err := kubernetes.NewKubernetesRole(c.kubeClient, c.appCatalogClient, role)
+			if err != nil {
+				glog.Errorf("KubernetesRole %s/%s finalizer: %v", role.Namespace, role.Name, err)
+			} else {
+				err = c.finalizeKubernetesRole(d, role)
+				if err != nil {
+					glog.Errorf("KubernetesRole %s/%s finalizer: %v", role.Namespace, role.Name, err)
+				} else {
+					finalizationDone = true
+				}
+			}
+		}
+
+		if finalizationDone {
+			err := c.removeKubernetesRoleFinalizer(role)
+			if err != nil {
+				glog.Errorf("KubernetesRole %s/%s finalizer: removing finalizer %v", role.Namespace, role.Name, err)
+			} else {
+				break
+			}
+		}
+
+		select {
+		case <-stopCh:
+			timeOutOccured = true
+		case <-time.After(interval):
+		}
+		attempt++
+	}
+
+	err := c.removeKubernetesRoleFinalizer(role)
+	if err != nil {
+		glog.Errorf("KubernetesRole %s/%s finalizer: removing finalizer %v", role.Namespace, role.Name, err)
+	} else {
+		glog.Infof("Removed finalizer for KubernetesRole %s/%s", role.Namespace, role.Name)
+	}
+
+	// Delete key from finalizer info as processing is done
+	c.finalizerInfo.Delete(id)
+}
+
+// Do:
+//	- delete role in vault
+func (c *VaultController) finalizeKubernetesRole(kRClient kubernetes.KubernetesRoleInterface, role *api.KubernetesRole) error {
+	err := kRClient.DeleteRole(role.RoleName())
+	if err != nil {
+		return errors.Wrap(err, "failed to delete kubernetes role")
+	}
+	return nil
+}
+
+func (c *VaultController) removeKubernetesRoleFinalizer(role *api.KubernetesRole) error {
+	m, err := c.extClient.EngineV1alpha1().KubernetesRoles(role.Namespace).Get(context.TODO(), role.Name, metav1.GetOptions{})
+	if kerr.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	// remove finalizer
+	_, _, err = patchutil.PatchKubernetesRole(context.TODO(), c.extClient.EngineV1alpha1(), m, func(role *api.KubernetesRole) *api.KubernetesRole {
+		role.ObjectMeta = core_util.RemoveFinalizer(role.ObjectMeta, KubernetesRoleFinalizer)
+		return role
+	}, metav1.PatchOptions{})
+	return err
+}
+
+func getKubernetesRoleId(role *api.KubernetesRole) string {
+	return fmt.Sprintf("%s/%s/%s", api.ResourceKubernetesRole, role.Namespace, role.Name)
+}
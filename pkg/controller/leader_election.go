@@ -0,0 +1,108 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"os"
+
+	"kubevault.dev/operator/pkg/server/options"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+// initWatchers wires up and starts the informers/queues for every resource
+// this controller reconciles, stopping them when stopCh is closed. With
+// leader election enabled it is only called once this replica is elected
+// leader (see Run), since starting it on more than one replica would cause
+// duplicate reconciles and finalizer races.
+func (c *VaultController) initWatchers(stopCh <-chan struct{}) {
+	c.initGCPRoleWatcher()
+	c.initKubernetesRoleWatcher()
+
+	c.extInformerFactory.Start(stopCh)
+	c.extInformerFactory.WaitForCacheSync(stopCh)
+
+	go c.gcpRoleQueue.Run(stopCh)
+	go c.kubernetesRoleQueue.Run(stopCh)
+}
+
+// Run starts the controller. If leader election is disabled, informers and
+// queues are started immediately, bound to ctx. Otherwise they are only
+// started once this replica is elected leader, bound to reconcileCtx, which
+// is cancelled the moment leadership is lost — so the informer factory stops
+// and both role queues' workers exit. Finalizer goroutines already started
+// (e.g. runKubernetesRoleFinalizer) are not bound to reconcileCtx: they keep
+// running until they finish or reach their own timeout.
+func (c *VaultController) Run(ctx context.Context, leaderElection *options.LeaderElectionOptions) error {
+	if leaderElection == nil || !leaderElection.LeaderElect {
+		c.initWatchers(ctx.Done())
+		<-ctx.Done()
+		return nil
+	}
+
+	id, err := os.Hostname()
+	if err != nil {
+		return errors.Wrap(err, "failed to determine leader election identity")
+	}
+
+	lock, err := resourcelock.New(
+		leaderElection.ResourceLock,
+		leaderElection.ResourceNamespace,
+		leaderElection.ResourceName,
+		c.kubeClient.CoreV1(),
+		c.kubeClient.CoordinationV1(),
+		resourcelock.ResourceLockConfig{Identity: id},
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create leader election lock")
+	}
+
+	reconcileCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	le, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:          lock,
+		LeaseDuration: leaderElection.LeaseDuration.Duration,
+		RenewDeadline: leaderElection.RenewDeadline.Duration,
+		RetryPeriod:   leaderElection.RetryPeriod.Duration,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(context.Context) {
+				glog.Infof("%s: started leading, starting informers/queues", id)
+				c.initWatchers(reconcileCtx.Done())
+			},
+			OnStoppedLeading: func() {
+				glog.Infof("%s: stopped leading, cancelling in-flight reconciles", id)
+				cancel()
+			},
+			OnNewLeader: func(identity string) {
+				if identity != id {
+					glog.Infof("new leader elected: %s", identity)
+				}
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create leader elector")
+	}
+
+	le.Run(reconcileCtx)
+	return nil
+}
@@ -0,0 +1,58 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package memprotect
+
+import (
+	"context"
+
+	"github.com/golang/glog"
+)
+
+// Init prepares the process for handling sensitive credential material: it
+// raises RLIMIT_MEMLOCK so later Protect calls don't fail once more than
+// the (often tiny) default soft limit's worth of secrets are locked, and
+// releases every lock via UnprotectAll once ctx is done. Call this once
+// from the operator entrypoint, before any Vault tokens, unseal keys, or
+// backend credentials are read, passing the same context the entrypoint
+// cancels on shutdown (e.g. the one VaultController.Run is given).
+//
+// Init deliberately does not install its own signal handler or terminate
+// the process: memory hygiene is a cleanup step, not a shutdown driver, and
+// racing the operator's own shutdown path — which cancels this same ctx to
+// stop leader election and let in-flight finalizers drain — with a second,
+// competing os.Exit would cut that draining short.
+//
+// disableMlock mirrors the --disable-mlock flag: when true, Protect and
+// UnprotectAll become no-ops and the rlimit is left untouched, for
+// environments where mlock isn't permitted.
+func Init(ctx context.Context, disableMlock bool) {
+	Disabled = disableMlock
+	if Disabled {
+		glog.Infoln("memprotect: mlock disabled, sensitive data may be paged to swap")
+		return
+	}
+
+	if err := RaiseRlimitMemlock(); err != nil {
+		glog.Warningf("memprotect: failed to raise RLIMIT_MEMLOCK, Protect calls may fail once the default limit is exceeded: %v", err)
+	}
+
+	go func() {
+		<-ctx.Done()
+		glog.Infoln("memprotect: shutting down, releasing locked memory")
+		UnprotectAll()
+	}()
+}
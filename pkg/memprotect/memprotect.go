@@ -0,0 +1,108 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package memprotect keeps sensitive strings the operator holds in memory
+// — Vault tokens (see pkg/vault.NewClient) and unseal keys (see
+// pkg/vault.ReadUnsealKeys) — from being paged to swap or captured in a
+// core dump, by mlocking the memory backing them.
+//
+// Not every credential the operator touches can go through Protect: the
+// storage backends under pkg/vault/storage (etcd's ETCD_PASSWORD, and the
+// GCP/AWS/Azure credentials for gcs/s3/azure/dynamodb) are wired into the
+// Vault pod via SecretKeyRef environment variables (or, for gcs, a mounted
+// Secret volume) resolved by the kubelet, so their plaintext values never pass through the operator's own
+// memory in the first place — there is nothing for Protect to lock. Only
+// mysql's backend currently reads credentials into the operator's memory
+// to render them into its storage config (see pkg/vault/storage/mysql),
+// and is routed through Protect there.
+package memprotect
+
+import (
+	"reflect"
+	"sync"
+	"unsafe"
+)
+
+var (
+	mu        sync.Mutex
+	protected = map[*string]struct{}{}
+)
+
+// Disabled, when set, makes Protect and UnprotectAll no-ops. Set this from
+// the operator entrypoint's --disable-mlock flag for environments (e.g.
+// restricted containers) where locking memory isn't permitted.
+var Disabled bool
+
+// Protect mlocks the memory backing *sensitive so the kernel will not swap
+// it out or include it in a core dump. It is a no-op for nil or empty
+// strings, and the locked pages stay locked until a later call to
+// Unprotect(sensitive) or UnprotectAll.
+func Protect(sensitive *string) error {
+	if Disabled || sensitive == nil || *sensitive == "" {
+		return nil
+	}
+
+	if err := mlock(stringBytes(sensitive)); err != nil {
+		return err
+	}
+
+	mu.Lock()
+	protected[sensitive] = struct{}{}
+	mu.Unlock()
+	return nil
+}
+
+// Unprotect releases the lock taken by a prior Protect(sensitive) call. It
+// is a no-op if sensitive was never protected. Callers that only need a
+// secret mlocked for the narrow window before handing it off (e.g. to a
+// client that takes its own copy) should call this once that window ends,
+// rather than relying solely on UnprotectAll at shutdown — otherwise every
+// call site that protects a freshly read secret leaks another locked page
+// for the life of the process.
+func Unprotect(sensitive *string) {
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := protected[sensitive]; !ok {
+		return
+	}
+	munlock(stringBytes(sensitive))
+	delete(protected, sensitive)
+}
+
+// UnprotectAll releases every lock taken by Protect. Intended to be called
+// from a shutdown signal handler so the process doesn't leave dangling
+// mlock'd pages behind.
+func UnprotectAll() {
+	mu.Lock()
+	defer mu.Unlock()
+	for sensitive := range protected {
+		munlock(stringBytes(sensitive))
+		delete(protected, sensitive)
+	}
+}
+
+// stringBytes returns a []byte view over the memory backing *s, without
+// copying it — copying would defeat the purpose, since the copy wouldn't
+// be locked.
+func stringBytes(s *string) []byte {
+	hdr := (*reflect.StringHeader)(unsafe.Pointer(s))
+	var b []byte
+	bh := (*reflect.SliceHeader)(unsafe.Pointer(&b))
+	bh.Data = hdr.Data
+	bh.Len = hdr.Len
+	bh.Cap = hdr.Len
+	return b
+}
@@ -0,0 +1,39 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+//go:build !linux
+// +build !linux
+
+package memprotect
+
+import (
+	"sync"
+
+	"github.com/golang/glog"
+)
+
+var warnOnce sync.Once
+
+func mlock(b []byte) error {
+	warnOnce.Do(func() {
+		glog.Warningln("memprotect: mlock is not supported on this platform; sensitive data may be paged to swap")
+	})
+	return nil
+}
+
+func munlock(b []byte) error {
+	return nil
+}
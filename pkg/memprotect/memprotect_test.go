@@ -0,0 +1,45 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package memprotect
+
+import "testing"
+
+func TestProtect_NoopWhenDisabled(t *testing.T) {
+	orig := Disabled
+	Disabled = true
+	defer func() { Disabled = orig }()
+
+	s := "super-secret"
+	if err := Protect(&s); err != nil {
+		t.Errorf("Protect() with Disabled=true returned error: %v", err)
+	}
+}
+
+func TestProtect_NoopForEmptyString(t *testing.T) {
+	s := ""
+	if err := Protect(&s); err != nil {
+		t.Errorf("Protect() on empty string returned error: %v", err)
+	}
+}
+
+func TestProtectAndUnprotectAll(t *testing.T) {
+	s := "super-secret-token"
+	if err := Protect(&s); err != nil {
+		t.Skipf("mlock not permitted in this environment: %v", err)
+	}
+	UnprotectAll()
+}
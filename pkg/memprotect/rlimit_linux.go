@@ -0,0 +1,35 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+//go:build linux
+// +build linux
+
+package memprotect
+
+import "syscall"
+
+// RaiseRlimitMemlock raises RLIMIT_MEMLOCK to its hard limit so Protect's
+// mlock calls don't fail once the operator has locked more than the
+// default (often 64KB) soft limit's worth of secrets.
+func RaiseRlimitMemlock() error {
+	var rlimit syscall.Rlimit
+	if err := syscall.Getrlimit(syscall.RLIMIT_MEMLOCK, &rlimit); err != nil {
+		return err
+	}
+
+	rlimit.Cur = rlimit.Max
+	return syscall.Setrlimit(syscall.RLIMIT_MEMLOCK, &rlimit)
+}
@@ -0,0 +1,71 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiserverconfig "k8s.io/apiserver/pkg/apis/config"
+)
+
+// LeaderElectionOptions configures how the operator elects a single active
+// leader when multiple replicas are running, so that only one replica
+// reconciles at a time.
+type LeaderElectionOptions struct {
+	apiserverconfig.LeaderElectionConfiguration
+
+	// ResourceNamespace is the namespace the Lease object used for leader
+	// election lives in.
+	ResourceNamespace string
+}
+
+// NewLeaderElectionOptions returns LeaderElectionOptions with leader
+// election disabled by default and the same timing defaults used
+// throughout client-go.
+func NewLeaderElectionOptions() *LeaderElectionOptions {
+	return &LeaderElectionOptions{
+		LeaderElectionConfiguration: apiserverconfig.LeaderElectionConfiguration{
+			LeaderElect:   false,
+			LeaseDuration: metav1.Duration{Duration: 15 * time.Second},
+			RenewDeadline: metav1.Duration{Duration: 10 * time.Second},
+			RetryPeriod:   metav1.Duration{Duration: 2 * time.Second},
+			ResourceLock:  "leases",
+			ResourceName:  "vault-operator",
+		},
+		ResourceNamespace: "kubevault",
+	}
+}
+
+// AddFlags registers the leader election flags on fs.
+func (o *LeaderElectionOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&o.LeaderElect, "leader-elect", o.LeaderElect,
+		"Run the operator with leader election enabled so only one replica is active at a time.")
+	fs.DurationVar(&o.LeaseDuration.Duration, "leader-elect-lease-duration", o.LeaseDuration.Duration,
+		"The duration that non-leader candidates will wait after observing a leadership renewal until attempting to acquire leadership of a held but unrenewed leader slot.")
+	fs.DurationVar(&o.RenewDeadline.Duration, "leader-elect-renew-deadline", o.RenewDeadline.Duration,
+		"The interval between attempts by the acting leader to renew a leadership slot before it stops leading.")
+	fs.DurationVar(&o.RetryPeriod.Duration, "leader-elect-retry-period", o.RetryPeriod.Duration,
+		"The duration the clients should wait between attempting acquisition and renewal of leadership.")
+	fs.StringVar(&o.ResourceLock, "leader-elect-resource-lock", o.ResourceLock,
+		"The type of resource object that is used for locking during leader election. Supported options are 'leases'.")
+	fs.StringVar(&o.ResourceName, "leader-elect-resource-name", o.ResourceName,
+		"The name of resource object that is used for locking during leader election.")
+	fs.StringVar(&o.ResourceNamespace, "leader-elect-resource-namespace", o.ResourceNamespace,
+		"The namespace of resource object that is used for locking during leader election.")
+}
@@ -0,0 +1,43 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+import "github.com/spf13/pflag"
+
+// MemProtectOptions configures whether the operator mlocks sensitive
+// credential material (Vault tokens, unseal keys, backend credentials) it
+// reads from Kubernetes Secrets.
+type MemProtectOptions struct {
+	// DisableMlock disables mlocking sensitive data, for environments
+	// (e.g. restricted containers) where CAP_IPC_LOCK isn't granted and
+	// mlock would otherwise fail.
+	DisableMlock bool
+}
+
+// NewMemProtectOptions returns MemProtectOptions with mlock enabled by
+// default.
+func NewMemProtectOptions() *MemProtectOptions {
+	return &MemProtectOptions{
+		DisableMlock: false,
+	}
+}
+
+// AddFlags registers the mlock flag on fs.
+func (o *MemProtectOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&o.DisableMlock, "disable-mlock", o.DisableMlock,
+		"Disable mlocking of sensitive credential material in memory. Only set this in environments where mlock is not permitted.")
+}
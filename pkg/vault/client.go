@@ -0,0 +1,108 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"context"
+	"sync"
+
+	"kubevault.dev/operator/pkg/memprotect"
+
+	vaultapi "github.com/hashicorp/vault/api"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	appcat_cs "kmodules.xyz/custom-resources/client/clientset/versioned"
+)
+
+// cachedClient is a vault client built from, and valid for as long as,
+// a specific generation of its token Secret.
+type cachedClient struct {
+	resourceVersion string
+	client          *vaultapi.Client
+}
+
+var (
+	clientCacheMu sync.Mutex
+	clientCache   = map[string]*cachedClient{}
+)
+
+// NewClient builds a Vault API client for the Vault server described by
+// the AppBinding vaultRef refers to in namespace ns, authenticated with
+// the token held in the AppBinding's referenced Secret.
+//
+// A client for a given AppBinding is cached and reused across calls as
+// long as its token Secret hasn't changed: client.SetToken stores the
+// token by a plain string assignment, which only copies the (pointer,
+// length) header, so the client and our local copy share the same
+// backing bytes for as long as the client lives. We deliberately never
+// unlock that memory — doing so the moment SetToken returns would unlock
+// the very bytes the client keeps using — and rely on this cache to keep
+// Protect from being called (and another copy of the token locked) on
+// every reconcile of every role that shares this AppBinding.
+func NewClient(kClient kubernetes.Interface, appClient appcat_cs.Interface, vaultRef core.LocalObjectReference, ns string) (*vaultapi.Client, error) {
+	appBinding, err := appClient.AppcatalogV1alpha1().AppBindings(ns).Get(context.TODO(), vaultRef.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get AppBinding %s/%s", ns, vaultRef.Name)
+	}
+
+	addr, err := appBinding.URL()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to resolve vault address from AppBinding %s/%s", ns, vaultRef.Name)
+	}
+
+	if appBinding.Spec.Secret == nil {
+		cfg := vaultapi.DefaultConfig()
+		cfg.Address = addr
+		return vaultapi.NewClient(cfg)
+	}
+
+	secret, err := kClient.CoreV1().Secrets(ns).Get(context.TODO(), appBinding.Spec.Secret.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get vault token secret %s/%s", ns, appBinding.Spec.Secret.Name)
+	}
+
+	cacheKey := ns + "/" + vaultRef.Name
+	clientCacheMu.Lock()
+	if cached, ok := clientCache[cacheKey]; ok && cached.resourceVersion == secret.ResourceVersion {
+		clientCacheMu.Unlock()
+		return cached.client, nil
+	}
+	clientCacheMu.Unlock()
+
+	cfg := vaultapi.DefaultConfig()
+	cfg.Address = addr
+
+	client, err := vaultapi.NewClient(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create vault client")
+	}
+
+	token := string(secret.Data["token"])
+	if err := memprotect.Protect(&token); err != nil {
+		glog.Warningf("failed to mlock vault token for AppBinding %s/%s: %v", ns, vaultRef.Name, err)
+	}
+	client.SetToken(token)
+
+	clientCacheMu.Lock()
+	clientCache[cacheKey] = &cachedClient{resourceVersion: secret.ResourceVersion, client: client}
+	clientCacheMu.Unlock()
+
+	return client, nil
+}
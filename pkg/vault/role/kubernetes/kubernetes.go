@@ -0,0 +1,127 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package kubernetes wraps Vault's Kubernetes secrets engine
+// (https://www.vaultproject.io/docs/secrets/kubernetes), which issues
+// short-lived, dynamically generated Kubernetes service account tokens.
+package kubernetes
+
+import (
+	"encoding/json"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	vaultapi "github.com/hashicorp/vault/api"
+	"k8s.io/client-go/kubernetes"
+
+	api "kubevault.dev/operator/apis/engine/v1alpha1"
+	"kubevault.dev/operator/pkg/vault"
+
+	appcat_cs "kmodules.xyz/custom-resources/client/clientset/versioned"
+)
+
+const (
+	DefaultKubernetesPath = "kubernetes"
+)
+
+// KubernetesRoleInterface is the interface for managing a role in Vault's
+// Kubernetes secrets engine.
+type KubernetesRoleInterface interface {
+	// CreateRole creates or updates the Kubernetes role in Vault.
+	CreateRole() error
+
+	// DeleteRole deletes the Kubernetes role named roleName from Vault.
+	DeleteRole(roleName string) error
+}
+
+type KubernetesRole struct {
+	vaultClient *vaultapi.Client
+	path        string
+	role        *api.KubernetesRole
+}
+
+var _ KubernetesRoleInterface = &KubernetesRole{}
+
+// NewKubernetesRole creates a KubernetesRole client for the Vault server
+// referenced by role.Spec.VaultRef.
+func NewKubernetesRole(kClient kubernetes.Interface, appClient appcat_cs.Interface, role *api.KubernetesRole) (KubernetesRoleInterface, error) {
+	vClient, err := vault.NewClient(kClient, appClient, role.Spec.VaultRef, role.Namespace)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create vault client")
+	}
+
+	path := role.Spec.Path
+	if path == "" {
+		path = DefaultKubernetesPath
+	}
+
+	return &KubernetesRole{
+		vaultClient: vClient,
+		path:        path,
+		role:        role,
+	}, nil
+}
+
+// CreateRole creates or updates the Kubernetes role in Vault by writing to
+// `<path>/roles/<roleName>`.
+func (k *KubernetesRole) CreateRole() error {
+	spec := k.role.Spec
+	payload := map[string]interface{}{
+		"allowed_kubernetes_namespaces": spec.AllowedKubernetesNamespaces,
+	}
+
+	if spec.ServiceAccountName != "" {
+		payload["service_account_name"] = spec.ServiceAccountName
+	}
+	if spec.KubernetesRoleName != "" {
+		payload["kubernetes_role_name"] = spec.KubernetesRoleName
+	}
+	if spec.KubernetesRoleType != "" {
+		payload["kubernetes_role_type"] = string(spec.KubernetesRoleType)
+	}
+	if len(spec.GeneratedRoleRules) > 0 {
+		rules, err := json.Marshal(spec.GeneratedRoleRules)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal generated role rules")
+		}
+		payload["generated_role_rules"] = string(rules)
+	}
+	if spec.TokenDefaultTTL != "" {
+		payload["token_default_ttl"] = spec.TokenDefaultTTL
+	}
+	if spec.TokenMaxTTL != "" {
+		payload["token_max_ttl"] = spec.TokenMaxTTL
+	}
+
+	_, err := k.vaultClient.Logical().Write(k.rolePath(k.role.RoleName()), payload)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create kubernetes role %s in vault", k.role.RoleName())
+	}
+	return nil
+}
+
+// DeleteRole deletes the Kubernetes role named roleName from Vault.
+func (k *KubernetesRole) DeleteRole(roleName string) error {
+	_, err := k.vaultClient.Logical().Delete(k.rolePath(roleName))
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete kubernetes role %s from vault", roleName)
+	}
+	return nil
+}
+
+func (k *KubernetesRole) rolePath(roleName string) string {
+	return filepath.Join(k.path, "roles", roleName)
+}
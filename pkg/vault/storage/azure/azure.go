@@ -0,0 +1,107 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"fmt"
+	"strings"
+
+	api "kubevault.dev/operator/apis/kubevault/v1alpha1"
+	"kubevault.dev/operator/pkg/vault/storage"
+
+	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// BackendName is the name this backend is registered under in
+// pkg/vault/storage.
+const BackendName = "azure"
+
+func init() {
+	storage.Register(BackendName, func(_ kubernetes.Interface, _ string, spec api.BackendStorageSpec) (storage.Backend, error) {
+		if spec.Azure == nil {
+			return nil, errors.Errorf("%s backend is not configured", BackendName)
+		}
+		return NewOptions(*spec.Azure)
+	})
+}
+
+var azureStorageFmt = `
+storage "azure" {
+%s
+}
+`
+
+type Options struct {
+	api.AzureSpec
+}
+
+var _ storage.Backend = &Options{}
+
+func NewOptions(s api.AzureSpec) (*Options, error) {
+	return &Options{s}, nil
+}
+
+// Name returns the backend's registered name, "azure".
+func (o *Options) Name() string {
+	return BackendName
+}
+
+// Apply injects AZURE_ACCOUNT_NAME/AZURE_ACCOUNT_KEY from
+// CredentialSecretName.
+func (o *Options) Apply(pt *core.PodTemplateSpec) error {
+	if o.CredentialSecretName == "" {
+		return nil
+	}
+
+	pt.Spec.Containers[0].Env = append(pt.Spec.Containers[0].Env,
+		core.EnvVar{
+			Name: "AZURE_ACCOUNT_NAME",
+			ValueFrom: &core.EnvVarSource{
+				SecretKeyRef: &core.SecretKeySelector{
+					LocalObjectReference: core.LocalObjectReference{Name: o.CredentialSecretName},
+					Key:                  "account_name",
+				},
+			},
+		},
+		core.EnvVar{
+			Name: "AZURE_ACCOUNT_KEY",
+			ValueFrom: &core.EnvVarSource{
+				SecretKeyRef: &core.SecretKeySelector{
+					LocalObjectReference: core.LocalObjectReference{Name: o.CredentialSecretName},
+					Key:                  "account_key",
+				},
+			},
+		},
+	)
+
+	return nil
+}
+
+// vault doc: https://www.vaultproject.io/docs/configuration/storage/azure
+//
+// GetStorageConfig creates azure storage config from AzureSpec
+func (o *Options) GetStorageConfig() (string, error) {
+	params := []string{}
+	if o.Container != "" {
+		params = append(params, fmt.Sprintf(`container = "%s"`, o.Container))
+	}
+
+	storageCfg := fmt.Sprintf(azureStorageFmt, strings.Join(params, "\n"))
+	return storageCfg, nil
+}
@@ -0,0 +1,101 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consul
+
+import (
+	"fmt"
+	"strings"
+
+	api "kubevault.dev/operator/apis/kubevault/v1alpha1"
+	"kubevault.dev/operator/pkg/vault/storage"
+
+	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// BackendName is the name this backend is registered under in
+// pkg/vault/storage.
+const BackendName = "consul"
+
+func init() {
+	storage.Register(BackendName, func(_ kubernetes.Interface, _ string, spec api.BackendStorageSpec) (storage.Backend, error) {
+		if spec.Consul == nil {
+			return nil, errors.Errorf("%s backend is not configured", BackendName)
+		}
+		return NewOptions(*spec.Consul)
+	})
+}
+
+var consulStorageFmt = `
+storage "consul" {
+%s
+}
+`
+
+type Options struct {
+	api.ConsulSpec
+}
+
+var _ storage.Backend = &Options{}
+
+func NewOptions(s api.ConsulSpec) (*Options, error) {
+	return &Options{s}, nil
+}
+
+// Name returns the backend's registered name, "consul".
+func (o *Options) Name() string {
+	return BackendName
+}
+
+// Apply injects CONSUL_HTTP_TOKEN from TokenSecretName, if provided.
+func (o *Options) Apply(pt *core.PodTemplateSpec) error {
+	if o.TokenSecretName == "" {
+		return nil
+	}
+
+	pt.Spec.Containers[0].Env = append(pt.Spec.Containers[0].Env, core.EnvVar{
+		Name: "CONSUL_HTTP_TOKEN",
+		ValueFrom: &core.EnvVarSource{
+			SecretKeyRef: &core.SecretKeySelector{
+				LocalObjectReference: core.LocalObjectReference{Name: o.TokenSecretName},
+				Key:                  "token",
+			},
+		},
+	})
+
+	return nil
+}
+
+// vault doc: https://www.vaultproject.io/docs/configuration/storage/consul
+//
+// GetStorageConfig creates consul storage config from ConsulSpec
+func (o *Options) GetStorageConfig() (string, error) {
+	params := []string{}
+	if o.Address != "" {
+		params = append(params, fmt.Sprintf(`address = "%s"`, o.Address))
+	}
+	if o.Path != "" {
+		params = append(params, fmt.Sprintf(`path = "%s"`, o.Path))
+	}
+	if o.Scheme != "" {
+		params = append(params, fmt.Sprintf(`scheme = "%s"`, o.Scheme))
+	}
+
+	storageCfg := fmt.Sprintf(consulStorageFmt, strings.Join(params, "\n"))
+	return storageCfg, nil
+}
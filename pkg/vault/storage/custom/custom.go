@@ -0,0 +1,93 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package custom implements the extension point for Vault storage backends
+// the operator has no built-in driver for: it emits a raw HCL block and
+// wires up whatever Secrets the backend's Vault plugin needs, rather than
+// requiring a KubeVault release for every new upstream storage driver.
+//
+// Unlike the built-in backends, custom isn't registered in
+// pkg/vault/storage's registry under a fixed name — its name comes from
+// the spec itself — so VaultServerSpec.Backend.Custom is built directly
+// via New instead of going through storage.NewBackend.
+package custom
+
+import (
+	"fmt"
+
+	api "kubevault.dev/operator/apis/kubevault/v1alpha1"
+	"kubevault.dev/operator/pkg/vault/storage"
+
+	core "k8s.io/api/core/v1"
+)
+
+const customSecretAssetDir = "/etc/vault/storage/custom/"
+
+type Options struct {
+	api.CustomStorageSpec
+}
+
+var _ storage.Backend = &Options{}
+
+// New builds a Backend for a user-defined storage driver from spec.
+func New(spec api.CustomStorageSpec) (*Options, error) {
+	if spec.Name == "" {
+		return nil, fmt.Errorf("custom storage backend requires a name")
+	}
+	return &Options{spec}, nil
+}
+
+// Name returns the user-supplied backend name, e.g. "cockroachdb".
+func (o *Options) Name() string {
+	return o.CustomStorageSpec.Name
+}
+
+// Apply mounts each referenced SecretVolume and injects each
+// EnvFromSecrets Secret as environment variables.
+func (o *Options) Apply(pt *core.PodTemplateSpec) error {
+	for i, sv := range o.SecretVolumes {
+		volumeName := fmt.Sprintf("vault-custom-storage-%d", i)
+		pt.Spec.Volumes = append(pt.Spec.Volumes, core.Volume{
+			Name: volumeName,
+			VolumeSource: core.VolumeSource{
+				Secret: &core.SecretVolumeSource{
+					SecretName: sv.SecretName,
+				},
+			},
+		})
+		pt.Spec.Containers[0].VolumeMounts = append(pt.Spec.Containers[0].VolumeMounts, core.VolumeMount{
+			Name:      volumeName,
+			MountPath: sv.MountPath,
+			ReadOnly:  true,
+		})
+	}
+
+	for _, secretName := range o.EnvFromSecrets {
+		pt.Spec.Containers[0].EnvFrom = append(pt.Spec.Containers[0].EnvFrom, core.EnvFromSource{
+			SecretRef: &core.SecretEnvSource{
+				LocalObjectReference: core.LocalObjectReference{Name: secretName},
+			},
+		})
+	}
+
+	return nil
+}
+
+// GetStorageConfig emits the `storage "<name>" { <parameters> }` stanza
+// verbatim from Parameters.
+func (o *Options) GetStorageConfig() (string, error) {
+	return fmt.Sprintf("\nstorage %q {\n%s\n}\n", o.Name(), o.Parameters), nil
+}
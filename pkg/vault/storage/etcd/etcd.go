@@ -20,18 +20,40 @@ import (
 	"fmt"
 	"path/filepath"
 	"strings"
+	"time"
 
 	api "kubevault.dev/operator/apis/kubevault/v1alpha1"
+	"kubevault.dev/operator/pkg/vault/storage"
 
+	"github.com/pkg/errors"
 	core "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
 )
 
+func init() {
+	storage.Register(BackendName, func(_ kubernetes.Interface, _ string, spec api.BackendStorageSpec) (storage.Backend, error) {
+		if spec.Etcd == nil {
+			return nil, errors.Errorf("%s backend is not configured", BackendName)
+		}
+		return NewOptions(*spec.Etcd)
+	})
+}
+
+// BackendName is the name this backend is registered under in
+// pkg/vault/storage.
+const BackendName = "etcd"
+
 const (
 	// TLS related file name for etcd
 	EtcdTLSAssetDir    = "/etc/vault/storage/etcd/tls/"
 	EtcdClientCaName   = "ca.crt"
 	EtcdClientCertName = "tls.crt"
 	EtcdClientKeyName  = "tls.key"
+
+	// DefaultRequestTimeout is used when EtcdSpec.RequestTimeout is unset.
+	DefaultRequestTimeout = "5s"
+	// DefaultLockTimeout is used when EtcdSpec.LockTimeout is unset.
+	DefaultLockTimeout = "60s"
 )
 
 var etcdStorageFmt = `
@@ -44,10 +66,50 @@ type Options struct {
 	api.EtcdSpec
 }
 
+var _ storage.Backend = &Options{}
+
 func NewOptions(s api.EtcdSpec) (*Options, error) {
-	return &Options{
-		s,
-	}, nil
+	o := &Options{s}
+	o.SetDefaults()
+
+	if err := o.Validate(); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// SetDefaults fills in default values for the tunable fields left unset on
+// the spec.
+func (o *Options) SetDefaults() {
+	if o.RequestTimeout == "" {
+		o.RequestTimeout = DefaultRequestTimeout
+	}
+	if o.LockTimeout == "" {
+		o.LockTimeout = DefaultLockTimeout
+	}
+}
+
+// Validate checks that the etcd storage options are well-formed.
+func (o *Options) Validate() error {
+	if o.RequestTimeout != "" {
+		if _, err := time.ParseDuration(o.RequestTimeout); err != nil {
+			return errors.Wrap(err, "invalid requestTimeout")
+		}
+	}
+	if o.LockTimeout != "" {
+		if _, err := time.ParseDuration(o.LockTimeout); err != nil {
+			return errors.Wrap(err, "invalid lockTimeout")
+		}
+	}
+	if o.MaxReceiveMessageSize < 0 {
+		return errors.New("maxReceiveMessageSize must not be negative")
+	}
+	return nil
+}
+
+// Name returns the backend's registered name, "etcd".
+func (o *Options) Name() string {
+	return BackendName
 }
 
 // Apply will do:
@@ -140,6 +202,15 @@ func (o *Options) GetStorageConfig() (string, error) {
 			fmt.Sprintf(`tls_cert_file = "%s"`, filepath.Join(EtcdTLSAssetDir, EtcdClientCertName)),
 			fmt.Sprintf(`tls_key_file = "%s"`, filepath.Join(EtcdTLSAssetDir, EtcdClientKeyName)))
 	}
+	if o.RequestTimeout != "" {
+		params = append(params, fmt.Sprintf(`request_timeout = "%s"`, o.RequestTimeout))
+	}
+	if o.LockTimeout != "" {
+		params = append(params, fmt.Sprintf(`lock_timeout = "%s"`, o.LockTimeout))
+	}
+	if o.MaxReceiveMessageSize > 0 {
+		params = append(params, fmt.Sprintf(`max_receive_message_size = "%d"`, o.MaxReceiveMessageSize))
+	}
 
 	storageCfg := fmt.Sprintf(etcdStorageFmt, strings.Join(params, "\n"))
 	return storageCfg, nil
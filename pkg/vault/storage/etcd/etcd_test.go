@@ -0,0 +1,93 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"strings"
+	"testing"
+
+	api "kubevault.dev/operator/apis/kubevault/v1alpha1"
+)
+
+func TestNewOptions_Defaults(t *testing.T) {
+	o, err := NewOptions(api.EtcdSpec{})
+	if err != nil {
+		t.Fatalf("NewOptions() returned error: %v", err)
+	}
+	if o.RequestTimeout != DefaultRequestTimeout {
+		t.Errorf("RequestTimeout = %q, want default %q", o.RequestTimeout, DefaultRequestTimeout)
+	}
+	if o.LockTimeout != DefaultLockTimeout {
+		t.Errorf("LockTimeout = %q, want default %q", o.LockTimeout, DefaultLockTimeout)
+	}
+}
+
+func TestNewOptions_InvalidValues(t *testing.T) {
+	cases := []api.EtcdSpec{
+		{RequestTimeout: "not-a-duration"},
+		{LockTimeout: "not-a-duration"},
+		{MaxReceiveMessageSize: -1},
+	}
+	for _, spec := range cases {
+		if _, err := NewOptions(spec); err == nil {
+			t.Errorf("NewOptions(%+v) expected error, got nil", spec)
+		}
+	}
+}
+
+func TestGetStorageConfig_NewFields(t *testing.T) {
+	o, err := NewOptions(api.EtcdSpec{
+		Address:               "http://etcd:2379",
+		RequestTimeout:        "10s",
+		LockTimeout:           "90s",
+		MaxReceiveMessageSize: 8388608,
+	})
+	if err != nil {
+		t.Fatalf("NewOptions() returned error: %v", err)
+	}
+
+	cfg, err := o.GetStorageConfig()
+	if err != nil {
+		t.Fatalf("GetStorageConfig() returned error: %v", err)
+	}
+
+	for _, want := range []string{
+		`request_timeout = "10s"`,
+		`lock_timeout = "90s"`,
+		`max_receive_message_size = "8388608"`,
+	} {
+		if !strings.Contains(cfg, want) {
+			t.Errorf("GetStorageConfig() = %q, want it to contain %q", cfg, want)
+		}
+	}
+}
+
+func TestGetStorageConfig_OmitsUnsetMaxReceiveMessageSize(t *testing.T) {
+	o, err := NewOptions(api.EtcdSpec{Address: "http://etcd:2379"})
+	if err != nil {
+		t.Fatalf("NewOptions() returned error: %v", err)
+	}
+
+	cfg, err := o.GetStorageConfig()
+	if err != nil {
+		t.Fatalf("GetStorageConfig() returned error: %v", err)
+	}
+
+	if strings.Contains(cfg, "max_receive_message_size") {
+		t.Errorf("GetStorageConfig() = %q, want no max_receive_message_size when unset", cfg)
+	}
+}
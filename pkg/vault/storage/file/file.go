@@ -0,0 +1,76 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"fmt"
+
+	api "kubevault.dev/operator/apis/kubevault/v1alpha1"
+	"kubevault.dev/operator/pkg/vault/storage"
+
+	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// BackendName is the name this backend is registered under in
+// pkg/vault/storage.
+const BackendName = "file"
+
+func init() {
+	storage.Register(BackendName, func(_ kubernetes.Interface, _ string, spec api.BackendStorageSpec) (storage.Backend, error) {
+		if spec.File == nil {
+			return nil, errors.Errorf("%s backend is not configured", BackendName)
+		}
+		return NewOptions(*spec.File)
+	})
+}
+
+var fileStorageFmt = `
+storage "file" {
+  path = "%s"
+}
+`
+
+type Options struct {
+	api.FileSpec
+}
+
+var _ storage.Backend = &Options{}
+
+func NewOptions(s api.FileSpec) (*Options, error) {
+	return &Options{s}, nil
+}
+
+// Name returns the backend's registered name, "file".
+func (o *Options) Name() string {
+	return BackendName
+}
+
+// Apply is a no-op; the file backend needs no credentials or TLS assets,
+// only the persistent volume already mounted at Path by the VaultServer's
+// pod spec.
+func (o *Options) Apply(pt *core.PodTemplateSpec) error {
+	return nil
+}
+
+// vault doc: https://www.vaultproject.io/docs/configuration/storage/filesystem
+//
+// GetStorageConfig creates file storage config from FileSpec
+func (o *Options) GetStorageConfig() (string, error) {
+	return fmt.Sprintf(fileStorageFmt, o.Path), nil
+}
@@ -0,0 +1,115 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcs
+
+import (
+	"fmt"
+	"strings"
+
+	api "kubevault.dev/operator/apis/kubevault/v1alpha1"
+	"kubevault.dev/operator/pkg/vault/storage"
+
+	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// BackendName is the name this backend is registered under in
+// pkg/vault/storage.
+const BackendName = "gcs"
+
+const gcsCredentialAssetDir = "/etc/vault/storage/gcs/"
+const gcsCredentialFile = "sa.json"
+
+func init() {
+	storage.Register(BackendName, func(_ kubernetes.Interface, _ string, spec api.BackendStorageSpec) (storage.Backend, error) {
+		if spec.GCS == nil {
+			return nil, errors.Errorf("%s backend is not configured", BackendName)
+		}
+		return NewOptions(*spec.GCS)
+	})
+}
+
+var gcsStorageFmt = `
+storage "gcs" {
+%s
+}
+`
+
+type Options struct {
+	api.GcsSpec
+}
+
+var _ storage.Backend = &Options{}
+
+func NewOptions(s api.GcsSpec) (*Options, error) {
+	return &Options{s}, nil
+}
+
+// Name returns the backend's registered name, "gcs".
+func (o *Options) Name() string {
+	return BackendName
+}
+
+// Apply mounts the GCP service account credentials secret, if provided,
+// and points GOOGLE_APPLICATION_CREDENTIALS at it.
+func (o *Options) Apply(pt *core.PodTemplateSpec) error {
+	if o.CredentialSecretName == "" {
+		return nil
+	}
+
+	credVolume := "vault-gcs-credential"
+	pt.Spec.Volumes = append(pt.Spec.Volumes, core.Volume{
+		Name: credVolume,
+		VolumeSource: core.VolumeSource{
+			Secret: &core.SecretVolumeSource{
+				SecretName: o.CredentialSecretName,
+			},
+		},
+	})
+
+	pt.Spec.Containers[0].VolumeMounts = append(pt.Spec.Containers[0].VolumeMounts, core.VolumeMount{
+		Name:      credVolume,
+		MountPath: gcsCredentialAssetDir,
+		ReadOnly:  true,
+	})
+
+	pt.Spec.Containers[0].Env = append(pt.Spec.Containers[0].Env, core.EnvVar{
+		Name:  "GOOGLE_APPLICATION_CREDENTIALS",
+		Value: gcsCredentialAssetDir + gcsCredentialFile,
+	})
+
+	return nil
+}
+
+// vault doc: https://www.vaultproject.io/docs/configuration/storage/google-cloud-storage
+//
+// GetStorageConfig creates gcs storage config from GcsSpec
+func (o *Options) GetStorageConfig() (string, error) {
+	params := []string{}
+	if o.Bucket != "" {
+		params = append(params, fmt.Sprintf(`bucket = "%s"`, o.Bucket))
+	}
+	if o.HAEnable {
+		params = append(params, `ha_enabled = "true"`)
+	} else {
+		params = append(params, `ha_enabled = "false"`)
+	}
+
+	storageCfg := fmt.Sprintf(gcsStorageFmt, strings.Join(params, "\n"))
+	return storageCfg, nil
+}
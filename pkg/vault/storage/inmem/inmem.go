@@ -0,0 +1,68 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inmem
+
+import (
+	api "kubevault.dev/operator/apis/kubevault/v1alpha1"
+	"kubevault.dev/operator/pkg/vault/storage"
+
+	core "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// BackendName is the name this backend is registered under in
+// pkg/vault/storage.
+const BackendName = "inmem"
+
+func init() {
+	storage.Register(BackendName, func(_ kubernetes.Interface, _ string, spec api.BackendStorageSpec) (storage.Backend, error) {
+		return NewOptions(api.InmemSpec{})
+	})
+}
+
+const inmemStorageCfg = `
+storage "inmem" {
+}
+`
+
+type Options struct {
+	api.InmemSpec
+}
+
+var _ storage.Backend = &Options{}
+
+func NewOptions(s api.InmemSpec) (*Options, error) {
+	return &Options{s}, nil
+}
+
+// Name returns the backend's registered name, "inmem".
+func (o *Options) Name() string {
+	return BackendName
+}
+
+// Apply is a no-op; the in-memory backend needs no volumes or credentials.
+func (o *Options) Apply(pt *core.PodTemplateSpec) error {
+	return nil
+}
+
+// vault doc: https://www.vaultproject.io/docs/configuration/storage/in-memory
+//
+// GetStorageConfig returns the inmem storage config. Not durable across
+// restarts; intended for dev/test VaultServers only.
+func (o *Options) GetStorageConfig() (string, error) {
+	return inmemStorageCfg, nil
+}
@@ -0,0 +1,36 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package install registers every built-in Vault storage backend driver
+// with pkg/vault/storage by blank-importing each backend package for its
+// init() side effect. Anything that needs the full set of built-in
+// backends available through storage.NewBackend (the reconciler, tests)
+// should import this package rather than the individual backend packages.
+package install
+
+import (
+	_ "kubevault.dev/operator/pkg/vault/storage/azure"
+	_ "kubevault.dev/operator/pkg/vault/storage/consul"
+	_ "kubevault.dev/operator/pkg/vault/storage/dynamodb"
+	_ "kubevault.dev/operator/pkg/vault/storage/etcd"
+	_ "kubevault.dev/operator/pkg/vault/storage/file"
+	_ "kubevault.dev/operator/pkg/vault/storage/gcs"
+	_ "kubevault.dev/operator/pkg/vault/storage/inmem"
+	_ "kubevault.dev/operator/pkg/vault/storage/mysql"
+	_ "kubevault.dev/operator/pkg/vault/storage/postgresql"
+	_ "kubevault.dev/operator/pkg/vault/storage/raft"
+	_ "kubevault.dev/operator/pkg/vault/storage/s3"
+)
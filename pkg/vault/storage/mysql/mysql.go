@@ -0,0 +1,130 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	api "kubevault.dev/operator/apis/kubevault/v1alpha1"
+	"kubevault.dev/operator/pkg/memprotect"
+	"kubevault.dev/operator/pkg/vault/storage"
+
+	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// BackendName is the name this backend is registered under in
+// pkg/vault/storage.
+const BackendName = "mysql"
+
+func init() {
+	storage.Register(BackendName, func(kClient kubernetes.Interface, ns string, spec api.BackendStorageSpec) (storage.Backend, error) {
+		if spec.MySQL == nil {
+			return nil, errors.Errorf("%s backend is not configured", BackendName)
+		}
+		return NewOptions(kClient, ns, *spec.MySQL)
+	})
+}
+
+var mysqlStorageFmt = `
+storage "mysql" {
+%s
+}
+`
+
+type Options struct {
+	api.MySQLSpec
+
+	username string
+	password string
+}
+
+var _ storage.Backend = &Options{}
+
+// NewOptions builds mysql storage config from MySQLSpec. Unlike the other
+// backends, it resolves CredentialSecretName here rather than in Apply:
+// Vault's mysql backend has no env-var fallback for username/password (see
+// GetStorageConfig), so the credentials must be rendered into the config
+// stanza itself, which means they need to be in hand before that stanza is
+// generated.
+func NewOptions(kClient kubernetes.Interface, ns string, s api.MySQLSpec) (*Options, error) {
+	o := &Options{MySQLSpec: s}
+	if s.CredentialSecretName == "" {
+		return o, nil
+	}
+
+	secret, err := kClient.CoreV1().Secrets(ns).Get(context.TODO(), s.CredentialSecretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get mysql credential secret %s/%s", ns, s.CredentialSecretName)
+	}
+
+	o.username = string(secret.Data["username"])
+	o.password = string(secret.Data["password"])
+	if err := memprotect.Protect(&o.password); err != nil {
+		return nil, errors.Wrap(err, "failed to mlock mysql password")
+	}
+
+	return o, nil
+}
+
+// Name returns the backend's registered name, "mysql".
+func (o *Options) Name() string {
+	return BackendName
+}
+
+// Apply is a no-op: unlike the other backends, mysql's credentials are
+// rendered directly into the storage stanza by GetStorageConfig rather
+// than injected as Vault pod environment variables, since Vault's mysql
+// backend doesn't read them from the environment.
+func (o *Options) Apply(pt *core.PodTemplateSpec) error {
+	return nil
+}
+
+// vault doc: https://www.vaultproject.io/docs/configuration/storage/mysql
+//
+// GetStorageConfig creates mysql storage config from MySQLSpec. username
+// and password are rendered directly into the stanza, not referenced via
+// environment variables, because Vault's mysql backend (unlike postgresql's
+// VAULT_PG_CONNECTION_URL) has no env-var fallback for them.
+func (o *Options) GetStorageConfig() (string, error) {
+	params := []string{}
+	if o.Address != "" {
+		params = append(params, fmt.Sprintf(`address = "%s"`, o.Address))
+	}
+	if o.Database != "" {
+		params = append(params, fmt.Sprintf(`database = "%s"`, o.Database))
+	}
+	if o.Table != "" {
+		params = append(params, fmt.Sprintf(`table = "%s"`, o.Table))
+	}
+	if o.username != "" {
+		// %q, not %s: an unescaped `"` or `\` in the credential would break
+		// out of the HCL string literal and corrupt (or silently alter) the
+		// generated stanza. HCL's double-quoted string escaping matches Go's.
+		params = append(params, fmt.Sprintf("username = %q", o.username))
+	}
+	if o.password != "" {
+		params = append(params, fmt.Sprintf("password = %q", o.password))
+	}
+
+	storageCfg := fmt.Sprintf(mysqlStorageFmt, strings.Join(params, "\n"))
+	return storageCfg, nil
+}
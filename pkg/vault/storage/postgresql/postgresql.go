@@ -0,0 +1,98 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgresql
+
+import (
+	"fmt"
+	"strings"
+
+	api "kubevault.dev/operator/apis/kubevault/v1alpha1"
+	"kubevault.dev/operator/pkg/vault/storage"
+
+	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// BackendName is the name this backend is registered under in
+// pkg/vault/storage.
+const BackendName = "postgresql"
+
+func init() {
+	storage.Register(BackendName, func(_ kubernetes.Interface, _ string, spec api.BackendStorageSpec) (storage.Backend, error) {
+		if spec.PostgreSQL == nil {
+			return nil, errors.Errorf("%s backend is not configured", BackendName)
+		}
+		return NewOptions(*spec.PostgreSQL)
+	})
+}
+
+var postgresqlStorageFmt = `
+storage "postgresql" {
+%s
+}
+`
+
+type Options struct {
+	api.PostgreSQLSpec
+}
+
+var _ storage.Backend = &Options{}
+
+func NewOptions(s api.PostgreSQLSpec) (*Options, error) {
+	return &Options{s}, nil
+}
+
+// Name returns the backend's registered name, "postgresql".
+func (o *Options) Name() string {
+	return BackendName
+}
+
+// Apply injects connection_url from ConnectionURLSecretName.
+func (o *Options) Apply(pt *core.PodTemplateSpec) error {
+	if o.ConnectionURLSecretName == "" {
+		return nil
+	}
+
+	pt.Spec.Containers[0].Env = append(pt.Spec.Containers[0].Env, core.EnvVar{
+		Name: "VAULT_PG_CONNECTION_URL",
+		ValueFrom: &core.EnvVarSource{
+			SecretKeyRef: &core.SecretKeySelector{
+				LocalObjectReference: core.LocalObjectReference{Name: o.ConnectionURLSecretName},
+				Key:                  "connection_url",
+			},
+		},
+	})
+
+	return nil
+}
+
+// vault doc: https://www.vaultproject.io/docs/configuration/storage/postgresql
+//
+// GetStorageConfig creates postgresql storage config from PostgreSQLSpec
+func (o *Options) GetStorageConfig() (string, error) {
+	params := []string{}
+	if o.Table != "" {
+		params = append(params, fmt.Sprintf(`table = "%s"`, o.Table))
+	}
+	if o.ConnectionURLSecretName != "" {
+		params = append(params, `connection_url = "$VAULT_PG_CONNECTION_URL"`)
+	}
+
+	storageCfg := fmt.Sprintf(postgresqlStorageFmt, strings.Join(params, "\n"))
+	return storageCfg, nil
+}
@@ -0,0 +1,85 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package raft
+
+import (
+	"fmt"
+	"strings"
+
+	api "kubevault.dev/operator/apis/kubevault/v1alpha1"
+	"kubevault.dev/operator/pkg/vault/storage"
+
+	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// BackendName is the name this backend is registered under in
+// pkg/vault/storage.
+const BackendName = "raft"
+
+func init() {
+	storage.Register(BackendName, func(_ kubernetes.Interface, _ string, spec api.BackendStorageSpec) (storage.Backend, error) {
+		if spec.Raft == nil {
+			return nil, errors.Errorf("%s backend is not configured", BackendName)
+		}
+		return NewOptions(*spec.Raft)
+	})
+}
+
+var raftStorageFmt = `
+storage "raft" {
+%s
+}
+`
+
+type Options struct {
+	api.RaftSpec
+}
+
+var _ storage.Backend = &Options{}
+
+func NewOptions(s api.RaftSpec) (*Options, error) {
+	return &Options{s}, nil
+}
+
+// Name returns the backend's registered name, "raft".
+func (o *Options) Name() string {
+	return BackendName
+}
+
+// Apply is a no-op; raft needs no credentials or TLS assets beyond the
+// persistent volume already mounted at Path by the VaultServer's pod spec.
+func (o *Options) Apply(pt *core.PodTemplateSpec) error {
+	return nil
+}
+
+// vault doc: https://www.vaultproject.io/docs/configuration/storage/raft
+//
+// GetStorageConfig creates raft storage config from RaftSpec
+func (o *Options) GetStorageConfig() (string, error) {
+	params := []string{}
+	if o.Path != "" {
+		params = append(params, fmt.Sprintf(`path = "%s"`, o.Path))
+	}
+	if o.NodeID != "" {
+		params = append(params, fmt.Sprintf(`node_id = "%s"`, o.NodeID))
+	}
+
+	storageCfg := fmt.Sprintf(raftStorageFmt, strings.Join(params, "\n"))
+	return storageCfg, nil
+}
@@ -0,0 +1,110 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"fmt"
+	"strings"
+
+	api "kubevault.dev/operator/apis/kubevault/v1alpha1"
+	"kubevault.dev/operator/pkg/vault/storage"
+
+	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// BackendName is the name this backend is registered under in
+// pkg/vault/storage.
+const BackendName = "s3"
+
+func init() {
+	storage.Register(BackendName, func(_ kubernetes.Interface, _ string, spec api.BackendStorageSpec) (storage.Backend, error) {
+		if spec.S3 == nil {
+			return nil, errors.Errorf("%s backend is not configured", BackendName)
+		}
+		return NewOptions(*spec.S3)
+	})
+}
+
+var s3StorageFmt = `
+storage "s3" {
+%s
+}
+`
+
+type Options struct {
+	api.S3Spec
+}
+
+var _ storage.Backend = &Options{}
+
+func NewOptions(s api.S3Spec) (*Options, error) {
+	return &Options{s}, nil
+}
+
+// Name returns the backend's registered name, "s3".
+func (o *Options) Name() string {
+	return BackendName
+}
+
+// Apply injects AWS_ACCESS_KEY_ID/AWS_SECRET_ACCESS_KEY from
+// CredentialSecretName, if provided.
+func (o *Options) Apply(pt *core.PodTemplateSpec) error {
+	if o.CredentialSecretName == "" {
+		return nil
+	}
+
+	pt.Spec.Containers[0].Env = append(pt.Spec.Containers[0].Env,
+		core.EnvVar{
+			Name: "AWS_ACCESS_KEY_ID",
+			ValueFrom: &core.EnvVarSource{
+				SecretKeyRef: &core.SecretKeySelector{
+					LocalObjectReference: core.LocalObjectReference{Name: o.CredentialSecretName},
+					Key:                  "access_key",
+				},
+			},
+		},
+		core.EnvVar{
+			Name: "AWS_SECRET_ACCESS_KEY",
+			ValueFrom: &core.EnvVarSource{
+				SecretKeyRef: &core.SecretKeySelector{
+					LocalObjectReference: core.LocalObjectReference{Name: o.CredentialSecretName},
+					Key:                  "secret_key",
+				},
+			},
+		},
+	)
+
+	return nil
+}
+
+// vault doc: https://www.vaultproject.io/docs/configuration/storage/s3
+//
+// GetStorageConfig creates s3 storage config from S3Spec
+func (o *Options) GetStorageConfig() (string, error) {
+	params := []string{}
+	if o.Bucket != "" {
+		params = append(params, fmt.Sprintf(`bucket = "%s"`, o.Bucket))
+	}
+	if o.Region != "" {
+		params = append(params, fmt.Sprintf(`region = "%s"`, o.Region))
+	}
+
+	storageCfg := fmt.Sprintf(s3StorageFmt, strings.Join(params, "\n"))
+	return storageCfg, nil
+}
@@ -0,0 +1,58 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"context"
+	"strings"
+
+	"kubevault.dev/operator/pkg/memprotect"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const unsealKeyShardPrefix = "key-shard-"
+
+// ReadUnsealKeys reads the Vault unseal key shards held in the named
+// Secret's `key-shard-*` entries and mlocks each one via memprotect.Protect
+// for the lifetime of the returned slice. Callers should call
+// memprotect.Unprotect on each returned key once they are done using it
+// (e.g. after submitting it to Vault's unseal endpoint).
+func ReadUnsealKeys(kClient kubernetes.Interface, ns, secretName string) ([]string, error) {
+	secret, err := kClient.CoreV1().Secrets(ns).Get(context.TODO(), secretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get unseal key secret %s/%s", ns, secretName)
+	}
+
+	keys := make([]string, 0, len(secret.Data))
+	for name, value := range secret.Data {
+		if !strings.HasPrefix(name, unsealKeyShardPrefix) {
+			continue
+		}
+
+		// keys never grows past its initial capacity, so &keys[i] stays the
+		// pointer Protect registered and a later Unprotect(&keys[i]) finds it.
+		keys = append(keys, string(value))
+		if err := memprotect.Protect(&keys[len(keys)-1]); err != nil {
+			glog.Warningf("failed to mlock unseal key %s from %s/%s: %v", name, ns, secretName, err)
+		}
+	}
+	return keys, nil
+}